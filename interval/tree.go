@@ -0,0 +1,243 @@
+// Package interval implements an interval tree: a container of
+// half-open intervals [lo, hi) that supports querying which
+// intervals overlap a point or range.
+//
+// It is built as an augmented splay tree: every node stores maxHi,
+// the greatest Hi in its subtree, which lets queries prune subtrees
+// that cannot possibly overlap. Insert and Delete splay the tree, so
+// recently inserted intervals migrate toward the root; Query and
+// QueryRange only read the tree and do not restructure it.
+package interval
+
+import "github.com/unixpickle/splaytree"
+
+// Entry is a single interval stored in an IntervalTree, covering the
+// half-open range [Lo, Hi) and carrying an arbitrary payload.
+type Entry[T splaytree.Value[T]] struct {
+	Lo, Hi  T
+	Payload any
+}
+
+type node[T splaytree.Value[T]] struct {
+	entry Entry[T]
+	maxHi T
+	left  *node[T]
+	right *node[T]
+}
+
+func (n *node[T]) updateMaxHi() {
+	m := n.entry.Hi
+	if n.left != nil && n.left.maxHi.Compare(m) > 0 {
+		m = n.left.maxHi
+	}
+	if n.right != nil && n.right.maxHi.Compare(m) > 0 {
+		m = n.right.maxHi
+	}
+	n.maxHi = m
+}
+
+func compare[T splaytree.Value[T]](aLo, aHi, bLo, bHi T) int {
+	if c := aLo.Compare(bLo); c != 0 {
+		return c
+	}
+	return aHi.Compare(bHi)
+}
+
+// IntervalTree stores half-open intervals [lo, hi) and supports
+// finding all intervals that overlap a point or a range.
+type IntervalTree[T splaytree.Value[T]] struct {
+	root *node[T]
+}
+
+// Insert adds the interval [lo, hi) with the given payload.
+// It is possible to have multiple copies of the same interval in a
+// tree at once.
+func (t *IntervalTree[T]) Insert(lo, hi T, payload any) {
+	entry := Entry[T]{Lo: lo, Hi: hi, Payload: payload}
+	if t.root == nil {
+		t.root = &node[T]{entry: entry, maxHi: hi}
+		return
+	}
+
+	splay(&t.root, func(n *node[T]) int {
+		return compare(lo, hi, n.entry.Lo, n.entry.Hi)
+	})
+
+	newNode := &node[T]{entry: entry}
+	c := compare(lo, hi, t.root.entry.Lo, t.root.entry.Hi)
+	if c < 0 {
+		newNode.left = t.root.left
+		t.root.left = nil
+		newNode.right = t.root
+	} else if c > 0 {
+		newNode.right = t.root.right
+		t.root.right = nil
+		newNode.left = t.root
+	} else {
+		newNode.left = t.root.left
+		newNode.right = t.root
+		t.root.left = nil
+	}
+	t.root.updateMaxHi()
+	newNode.updateMaxHi()
+	t.root = newNode
+}
+
+// Delete removes one interval matching [lo, hi) exactly, reporting
+// whether a matching interval was found.
+// If the interval is present more than once, only one instance is
+// deleted.
+func (t *IntervalTree[T]) Delete(lo, hi T) bool {
+	if t.root == nil {
+		return false
+	}
+	splay(&t.root, func(n *node[T]) int {
+		return compare(lo, hi, n.entry.Lo, n.entry.Hi)
+	})
+
+	if compare(lo, hi, t.root.entry.Lo, t.root.entry.Hi) != 0 {
+		return false
+	}
+
+	if t.root.left == nil {
+		t.root = t.root.right
+	} else {
+		splay(&t.root.left, func(n *node[T]) int { return 1 })
+		t.root.left.right = t.root.right
+		t.root.left.updateMaxHi()
+		t.root = t.root.left
+	}
+	return true
+}
+
+// Query finds every stored interval containing point, i.e. every
+// [lo, hi) with lo <= point < hi.
+func (t *IntervalTree[T]) Query(point T) []Entry[T] {
+	var results []Entry[T]
+	t.root.query(point, &results)
+	return results
+}
+
+func (n *node[T]) query(point T, results *[]Entry[T]) {
+	if n == nil {
+		return
+	}
+	if n.left != nil && n.left.maxHi.Compare(point) > 0 {
+		n.left.query(point, results)
+	}
+	if n.entry.Lo.Compare(point) <= 0 && n.entry.Hi.Compare(point) > 0 {
+		*results = append(*results, n.entry)
+	}
+	if n.entry.Lo.Compare(point) <= 0 && n.right != nil {
+		n.right.query(point, results)
+	}
+}
+
+// QueryRange finds every stored interval overlapping the half-open
+// range [lo, hi).
+func (t *IntervalTree[T]) QueryRange(lo, hi T) []Entry[T] {
+	var results []Entry[T]
+	t.root.queryRange(lo, hi, &results)
+	return results
+}
+
+func (n *node[T]) queryRange(lo, hi T, results *[]Entry[T]) {
+	if n == nil {
+		return
+	}
+	if n.left != nil && n.left.maxHi.Compare(lo) > 0 {
+		n.left.queryRange(lo, hi, results)
+	}
+	if n.entry.Lo.Compare(hi) < 0 && n.entry.Hi.Compare(lo) > 0 {
+		*results = append(*results, n.entry)
+	}
+	if n.entry.Lo.Compare(hi) < 0 && n.right != nil {
+		n.right.queryRange(lo, hi, results)
+	}
+}
+
+// splay searches for the node selected by cmp (cmp returns the
+// signed comparison of the target to a candidate node, à la
+// Value.Compare) and splays the tree as it does so. If a matching
+// node is found, it becomes the root.
+//
+// Every rotation recomputes maxHi for the nodes it touches, bottom
+// up, since rotations change subtree membership.
+func splay[T splaytree.Value[T]](root **node[T], cmp func(*node[T]) int) {
+	if (*root) == nil {
+		return
+	}
+	n := (*root)
+	comparison := cmp(n)
+	if comparison < 0 {
+		if n.left == nil {
+			return
+		}
+		n1 := n.left
+		comparison = cmp(n1)
+		if comparison > 0 && n1.right != nil {
+			splay(&n1.right, cmp)
+			n2 := n1.right
+			n.left = n2.right
+			n2.right = n
+			n1.right = n2.left
+			n2.left = n1
+			n.updateMaxHi()
+			n1.updateMaxHi()
+			n2.updateMaxHi()
+			(*root) = n2
+		} else if comparison < 0 && n1.left != nil {
+			splay(&n1.left, cmp)
+			n2 := n1.left
+			n.left = n1.right
+			n1.right = n
+			n1.left = n2.right
+			n2.right = n1
+			n.updateMaxHi()
+			n1.updateMaxHi()
+			n2.updateMaxHi()
+			(*root) = n2
+		} else {
+			(*root) = n1
+			n.left = n1.right
+			n1.right = n
+			n.updateMaxHi()
+			n1.updateMaxHi()
+		}
+	} else if comparison > 0 {
+		if n.right == nil {
+			return
+		}
+		n1 := n.right
+		comparison = cmp(n1)
+		if comparison < 0 && n1.left != nil {
+			splay(&n1.left, cmp)
+			n2 := n1.left
+			n.right = n2.left
+			n2.left = n
+			n1.left = n2.right
+			n2.right = n1
+			n.updateMaxHi()
+			n1.updateMaxHi()
+			n2.updateMaxHi()
+			(*root) = n2
+		} else if comparison > 0 && n1.right != nil {
+			splay(&n1.right, cmp)
+			n2 := n1.right
+			n.right = n1.left
+			n1.left = n
+			n1.right = n2.left
+			n2.left = n1
+			n.updateMaxHi()
+			n1.updateMaxHi()
+			n2.updateMaxHi()
+			(*root) = n2
+		} else {
+			(*root) = n1
+			n.right = n1.left
+			n1.left = n
+			n.updateMaxHi()
+			n1.updateMaxHi()
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package interval
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+type IntValue int
+
+func (n IntValue) Compare(x IntValue) int {
+	if n > x {
+		return 1
+	} else if n < x {
+		return -1
+	} else {
+		return 0
+	}
+}
+
+type ivRange struct {
+	lo, hi int
+}
+
+func TestQueryPoint(t *testing.T) {
+	tree := &IntervalTree[IntValue]{}
+	var intervals []ivRange
+	for i := 0; i < 200; i++ {
+		lo := rand.Intn(100)
+		hi := lo + 1 + rand.Intn(20)
+		intervals = append(intervals, ivRange{lo, hi})
+		tree.Insert(IntValue(lo), IntValue(hi), i)
+	}
+
+	for _, point := range []int{0, 10, 50, 99, 119} {
+		var expected []int
+		for i, iv := range intervals {
+			if iv.lo <= point && point < iv.hi {
+				expected = append(expected, i)
+			}
+		}
+
+		var actual []int
+		for _, e := range tree.Query(IntValue(point)) {
+			actual = append(actual, e.Payload.(int))
+		}
+
+		sort.Ints(expected)
+		sort.Ints(actual)
+		if !equalInts(expected, actual) {
+			t.Fatalf("point %d: expected %v but got %v", point, expected, actual)
+		}
+	}
+}
+
+func TestQueryRange(t *testing.T) {
+	tree := &IntervalTree[IntValue]{}
+	var intervals []ivRange
+	for i := 0; i < 200; i++ {
+		lo := rand.Intn(100)
+		hi := lo + 1 + rand.Intn(20)
+		intervals = append(intervals, ivRange{lo, hi})
+		tree.Insert(IntValue(lo), IntValue(hi), i)
+	}
+
+	queryLo, queryHi := 30, 60
+	var expected []int
+	for i, iv := range intervals {
+		if iv.lo < queryHi && iv.hi > queryLo {
+			expected = append(expected, i)
+		}
+	}
+
+	var actual []int
+	for _, e := range tree.QueryRange(IntValue(queryLo), IntValue(queryHi)) {
+		actual = append(actual, e.Payload.(int))
+	}
+
+	sort.Ints(expected)
+	sort.Ints(actual)
+	if !equalInts(expected, actual) {
+		t.Fatalf("expected %v but got %v", expected, actual)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tree := &IntervalTree[IntValue]{}
+	tree.Insert(IntValue(10), IntValue(20), "a")
+	tree.Insert(IntValue(15), IntValue(25), "b")
+	tree.Insert(IntValue(30), IntValue(40), "c")
+
+	if !tree.Delete(IntValue(15), IntValue(25)) {
+		t.Fatal("expected to delete an existing interval")
+	}
+	if tree.Delete(IntValue(15), IntValue(25)) {
+		t.Fatal("should not be able to delete the same interval twice")
+	}
+
+	results := tree.Query(IntValue(16))
+	if len(results) != 1 || results[0].Payload.(string) != "a" {
+		t.Fatalf("unexpected results after delete: %v", results)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
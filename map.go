@@ -0,0 +1,136 @@
+package splaytree
+
+// mapEntry is the value stored in the splay tree that backs a Map.
+// Only the Key is used for ordering; the Val rides along with it.
+type mapEntry[K Value[K], V any] struct {
+	Key K
+	Val V
+}
+
+func (e mapEntry[K, V]) Compare(e2 mapEntry[K, V]) int {
+	return e.Key.Compare(e2.Key)
+}
+
+// Map is an ordered key/value map backed by a splay tree.
+// Like Tree, it gives working-set caching for free: recently
+// accessed keys migrate toward the root.
+type Map[K Value[K], V any] struct {
+	tree Tree[mapEntry[K, V]]
+}
+
+// Put associates v with k, replacing any value already
+// associated with k.
+func (m *Map[K, V]) Put(k K, v V) {
+	m.tree.Delete(mapEntry[K, V]{Key: k})
+	m.tree.Insert(mapEntry[K, V]{Key: k, Val: v})
+}
+
+// Get looks up the value associated with k.
+// The second return value indicates whether k was found.
+func (m *Map[K, V]) Get(k K) (V, bool) {
+	var result V
+	found := false
+	m.tree.AscendGreaterOrEqual(mapEntry[K, V]{Key: k}, func(e mapEntry[K, V]) bool {
+		if e.Key.Compare(k) == 0 {
+			result = e.Val
+			found = true
+		}
+		return false
+	})
+	return result, found
+}
+
+// Delete removes k and its associated value from the map.
+// The second return value indicates whether k was found.
+func (m *Map[K, V]) Delete(k K) (V, bool) {
+	v, ok := m.Get(k)
+	if ok {
+		m.tree.Delete(mapEntry[K, V]{Key: k})
+	}
+	return v, ok
+}
+
+// Len gets the number of key/value pairs stored in the map.
+func (m *Map[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// Iterate iterates over pairs in the map from least to greatest key.
+// Calls f for each pair, breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) Iterate(f func(K, V) bool) {
+	m.tree.Iterate(func(e mapEntry[K, V]) bool {
+		return f(e.Key, e.Val)
+	})
+}
+
+// Ascend is an alias for Iterate, iterating over pairs in the
+// map from least to greatest key.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) Ascend(f func(K, V) bool) {
+	m.Iterate(f)
+}
+
+// Descend iterates over pairs in the map from greatest to least key.
+// Calls f for each pair, breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) Descend(f func(K, V) bool) {
+	m.tree.Descend(func(e mapEntry[K, V]) bool {
+		return f(e.Key, e.Val)
+	})
+}
+
+// AscendGreaterOrEqual iterates, in ascending order, over every pair
+// whose key is greater than or equal to pivot. Calls f for each pair,
+// breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) AscendGreaterOrEqual(pivot K, f func(K, V) bool) {
+	m.tree.AscendGreaterOrEqual(mapEntry[K, V]{Key: pivot}, func(e mapEntry[K, V]) bool {
+		return f(e.Key, e.Val)
+	})
+}
+
+// DescendLessOrEqual iterates, in descending order, over every pair
+// whose key is less than or equal to pivot. Calls f for each pair,
+// breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) DescendLessOrEqual(pivot K, f func(K, V) bool) {
+	m.tree.DescendLessOrEqual(mapEntry[K, V]{Key: pivot}, func(e mapEntry[K, V]) bool {
+		return f(e.Key, e.Val)
+	})
+}
+
+// AscendRange iterates, in ascending order, over every pair whose key
+// k satisfies greaterOrEqual <= k < lessThan. Calls f for each pair,
+// breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) AscendRange(greaterOrEqual, lessThan K, f func(K, V) bool) {
+	m.tree.AscendRange(
+		mapEntry[K, V]{Key: greaterOrEqual},
+		mapEntry[K, V]{Key: lessThan},
+		func(e mapEntry[K, V]) bool {
+			return f(e.Key, e.Val)
+		},
+	)
+}
+
+// DescendRange iterates, in descending order, over every pair whose
+// key k satisfies greaterThan < k <= lessOrEqual. Calls f for each
+// pair, breaking the loop if f returns false.
+//
+// It is not safe to modify the map during iteration.
+func (m *Map[K, V]) DescendRange(lessOrEqual, greaterThan K, f func(K, V) bool) {
+	m.tree.DescendRange(
+		mapEntry[K, V]{Key: lessOrEqual},
+		mapEntry[K, V]{Key: greaterThan},
+		func(e mapEntry[K, V]) bool {
+			return f(e.Key, e.Val)
+		},
+	)
+}
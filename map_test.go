@@ -0,0 +1,101 @@
+package splaytree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMapPutGet(t *testing.T) {
+	m := &Map[NumValue, string]{}
+	expected := map[int]string{}
+	for i := 0; i < 1000; i++ {
+		k := rand.Intn(200)
+		v := randString()
+		expected[k] = v
+		m.Put(NumValue(k), v)
+	}
+
+	if m.Len() != len(expected) {
+		t.Fatalf("expected length %d but got %d", len(expected), m.Len())
+	}
+
+	for k, v := range expected {
+		actual, ok := m.Get(NumValue(k))
+		if !ok {
+			t.Fatalf("missing key %d", k)
+		}
+		if actual != v {
+			t.Fatalf("expected value %q for key %d but got %q", v, k, actual)
+		}
+	}
+
+	if _, ok := m.Get(NumValue(-1)); ok {
+		t.Fatal("found value for missing key")
+	}
+}
+
+func TestMapDelete(t *testing.T) {
+	m := &Map[NumValue, string]{}
+	for i := 0; i < 100; i++ {
+		m.Put(NumValue(i), randString())
+	}
+
+	for i := 0; i < 100; i += 2 {
+		v, ok := m.Delete(NumValue(i))
+		if !ok {
+			t.Fatalf("expected to delete key %d", i)
+		}
+		if v == "" {
+			t.Fatalf("expected non-empty value for key %d", i)
+		}
+	}
+
+	if m.Len() != 50 {
+		t.Fatalf("expected length 50 but got %d", m.Len())
+	}
+
+	for i := 0; i < 100; i++ {
+		_, ok := m.Get(NumValue(i))
+		if ok != (i%2 == 1) {
+			t.Fatalf("unexpected presence for key %d: %v", i, ok)
+		}
+	}
+
+	if _, ok := m.Delete(NumValue(-1)); ok {
+		t.Fatal("deleted a key that was never present")
+	}
+}
+
+func TestMapAscendRange(t *testing.T) {
+	m := &Map[NumValue, int]{}
+	for i := 0; i < 100; i++ {
+		m.Put(NumValue(i), i*i)
+	}
+
+	var keys []int
+	m.AscendRange(NumValue(20), NumValue(30), func(k NumValue, v int) bool {
+		keys = append(keys, int(k))
+		if v != int(k)*int(k) {
+			t.Fatalf("unexpected value %d for key %d", v, k)
+		}
+		return true
+	})
+
+	if len(keys) != 10 {
+		t.Fatalf("expected 10 keys but got %d", len(keys))
+	}
+	for i, k := range keys {
+		if k != 20+i {
+			t.Fatalf("keys not visited in order: %v", keys)
+		}
+	}
+}
+
+func randString() string {
+	const chars = "abcdefghijklmnopqrstuvwxyz"
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = chars[rand.Intn(len(chars))]
+	}
+	return string(b)
+}
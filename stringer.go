@@ -10,13 +10,13 @@ import (
 // the tree.
 // Values are formatted using Go's fmt
 // package.
-func (t *Tree) String() string {
+func (t *Tree[T]) String() string {
 	return t.Root.String()
 }
 
 // String behaves much like Tree.String(),
 // only for a node within a tree.
-func (n *Node) String() string {
+func (n *Node[T]) String() string {
 	if n == nil {
 		return "nil"
 	}
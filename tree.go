@@ -17,6 +17,12 @@ type Node[T Value[T]] struct {
 	Value T
 	Left  *Node[T]
 	Right *Node[T]
+
+	// shared marks a node that may be reachable from more than one
+	// Tree (e.g. the root after Clone). Its descendants are flagged
+	// lazily, when it is copied. A shared node must be path-copied
+	// before any of its fields are mutated.
+	shared bool
 }
 
 // Tree is a splay tree, which consists of
@@ -25,6 +31,19 @@ type Tree[T Value[T]] struct {
 	Root *Node[T]
 }
 
+// Clone returns a new tree containing the same values as t.
+//
+// The returned tree and t can be used and mutated independently of
+// one another; neither tree's future Insert or Delete calls will be
+// visible in the other. Clone runs in O(1) time, since the trees only
+// diverge lazily as mutations path-copy shared nodes.
+func (t *Tree[T]) Clone() *Tree[T] {
+	if t.Root != nil {
+		t.Root.shared = true
+	}
+	return &Tree[T]{Root: t.Root}
+}
+
 // Insert inserts the value into the tree.
 // It is possible to have multiple copies
 // of the same value in a tree at once.
@@ -35,6 +54,7 @@ func (t *Tree[T]) Insert(v T) {
 	}
 
 	splay(&t.Root, v)
+	t.Root = copyIfShared(t.Root)
 
 	comparison := v.Compare(t.Root.Value)
 	newNode := &Node[T]{Value: v}
@@ -67,11 +87,13 @@ func (t *Tree[T]) Delete(v Value[T]) {
 	if v.Compare(t.Root.Value) != 0 {
 		return
 	}
+	t.Root = copyIfShared(t.Root)
 
 	if t.Root.Left == nil {
 		t.Root = t.Root.Right
 	} else {
 		splay(&t.Root.Left, greatestValue[T]{})
+		t.Root.Left = copyIfShared(t.Root.Left)
 		t.Root.Left.Right = t.Root.Right
 		t.Root = t.Root.Left
 	}
@@ -124,6 +146,208 @@ func (t *Tree[T]) Iterate(f func(T) bool) {
 	t.Root.Iterate(f)
 }
 
+// Ascend is an alias for Iterate, iterating over elements in the
+// tree from least to greatest.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) Ascend(f func(T) bool) {
+	t.Root.Iterate(f)
+}
+
+// Descend iterates over elements in the tree from greatest to least.
+// Calls f for each element, breaking the loop if f returns false.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) Descend(f func(T) bool) {
+	t.Root.Descend(f)
+}
+
+// AscendGreaterOrEqual iterates, in ascending order, over every value
+// greater than or equal to pivot. Calls f for each element, breaking
+// the loop if f returns false.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) AscendGreaterOrEqual(pivot T, f func(T) bool) {
+	if t.Root == nil {
+		return
+	}
+	splay(&t.Root, pivot)
+	t.Root.ascendGreaterOrEqual(pivot, f)
+}
+
+// DescendLessOrEqual iterates, in descending order, over every value
+// less than or equal to pivot. Calls f for each element, breaking the
+// loop if f returns false.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) DescendLessOrEqual(pivot T, f func(T) bool) {
+	if t.Root == nil {
+		return
+	}
+	splay(&t.Root, pivot)
+	t.Root.descendLessOrEqual(pivot, f)
+}
+
+// AscendRange iterates, in ascending order, over every value v such
+// that greaterOrEqual <= v < lessThan. Calls f for each element,
+// breaking the loop if f returns false.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) AscendRange(greaterOrEqual, lessThan T, f func(T) bool) {
+	if t.Root == nil {
+		return
+	}
+	splay(&t.Root, greaterOrEqual)
+	t.Root.ascendRange(greaterOrEqual, lessThan, f)
+}
+
+// DescendRange iterates, in descending order, over every value v such
+// that greaterThan < v <= lessOrEqual. Calls f for each element,
+// breaking the loop if f returns false.
+//
+// It is not safe to modify the tree during iteration.
+func (t *Tree[T]) DescendRange(lessOrEqual, greaterThan T, f func(T) bool) {
+	if t.Root == nil {
+		return
+	}
+	splay(&t.Root, lessOrEqual)
+	t.Root.descendRange(lessOrEqual, greaterThan, f)
+}
+
+// Iterator is a stateful cursor over a Tree, positioned either at a
+// value or at one of the two boundaries just before the first value
+// or just after the last value.
+//
+// Since a Node has no parent pointer, the iterator maintains an
+// explicit stack of ancestors of the current value in its place.
+//
+// The tree must not be mutated while an Iterator is in use.
+type Iterator[T Value[T]] struct {
+	root  *Node[T]
+	stack []*Node[T]
+	atEnd bool
+}
+
+// Iterator creates an Iterator over the tree, initially positioned
+// just before the first value.
+func (t *Tree[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{root: t.Root}
+}
+
+// Value returns the value at the iterator's current position.
+// It panics if the iterator is positioned at a boundary; Next and
+// Prev report whether they came to rest at a value.
+func (it *Iterator[T]) Value() T {
+	return it.stack[len(it.stack)-1].Value
+}
+
+// SeekFirst positions the iterator at the smallest value in the
+// tree.
+func (it *Iterator[T]) SeekFirst() {
+	it.stack = it.stack[:0]
+	it.atEnd = false
+	for n := it.root; n != nil; n = n.Left {
+		it.stack = append(it.stack, n)
+	}
+}
+
+// SeekLast positions the iterator at the largest value in the tree.
+func (it *Iterator[T]) SeekLast() {
+	it.stack = it.stack[:0]
+	it.atEnd = true
+	for n := it.root; n != nil; n = n.Right {
+		it.stack = append(it.stack, n)
+	}
+}
+
+// Seek positions the iterator at the greatest value less than or
+// equal to v, or at the before-the-first boundary if no such value
+// exists.
+func (it *Iterator[T]) Seek(v T) {
+	it.stack = it.stack[:0]
+	it.atEnd = false
+	for n := it.root; n != nil; {
+		it.stack = append(it.stack, n)
+		comparison := v.Compare(n.Value)
+		if comparison < 0 {
+			n = n.Left
+		} else if comparison > 0 {
+			n = n.Right
+		} else {
+			return
+		}
+	}
+	for len(it.stack) > 0 && v.Compare(it.stack[len(it.stack)-1].Value) < 0 {
+		it.stack = it.stack[:len(it.stack)-1]
+	}
+}
+
+// Next moves the iterator to the next greater value and returns it.
+// The second return value is false if the iterator was already at
+// (or moves past) the last value, in which case it comes to rest at
+// the after-the-last boundary.
+func (it *Iterator[T]) Next() (T, bool) {
+	if len(it.stack) == 0 {
+		if it.atEnd {
+			var zero T
+			return zero, false
+		}
+		it.SeekFirst()
+	} else if n := it.stack[len(it.stack)-1].Right; n != nil {
+		for n != nil {
+			it.stack = append(it.stack, n)
+			n = n.Left
+		}
+	} else {
+		for len(it.stack) > 0 {
+			child := it.stack[len(it.stack)-1]
+			it.stack = it.stack[:len(it.stack)-1]
+			if len(it.stack) > 0 && it.stack[len(it.stack)-1].Left == child {
+				break
+			}
+		}
+	}
+	if len(it.stack) == 0 {
+		it.atEnd = true
+		var zero T
+		return zero, false
+	}
+	return it.stack[len(it.stack)-1].Value, true
+}
+
+// Prev moves the iterator to the next smaller value and returns it.
+// The second return value is false if the iterator was already at
+// (or moves past) the first value, in which case it comes to rest at
+// the before-the-first boundary.
+func (it *Iterator[T]) Prev() (T, bool) {
+	if len(it.stack) == 0 {
+		if !it.atEnd {
+			var zero T
+			return zero, false
+		}
+		it.SeekLast()
+	} else if n := it.stack[len(it.stack)-1].Left; n != nil {
+		for n != nil {
+			it.stack = append(it.stack, n)
+			n = n.Right
+		}
+	} else {
+		for len(it.stack) > 0 {
+			child := it.stack[len(it.stack)-1]
+			it.stack = it.stack[:len(it.stack)-1]
+			if len(it.stack) > 0 && it.stack[len(it.stack)-1].Right == child {
+				break
+			}
+		}
+	}
+	if len(it.stack) == 0 {
+		it.atEnd = false
+		var zero T
+		return zero, false
+	}
+	return it.stack[len(it.stack)-1].Value, true
+}
+
 func (n *Node[T]) height() int {
 	if n == nil {
 		return 0
@@ -163,72 +387,220 @@ func (n *Node[T]) Iterate(f func(T) bool) bool {
 	return true
 }
 
+func (n *Node[T]) Descend(f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.Right != nil {
+		if !n.Right.Descend(f) {
+			return false
+		}
+	}
+	if !f(n.Value) {
+		return false
+	}
+	if n.Left != nil {
+		return n.Left.Descend(f)
+	}
+	return true
+}
+
+func (n *Node[T]) ascendGreaterOrEqual(pivot T, f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.Value.Compare(pivot) < 0 {
+		return n.Right.ascendGreaterOrEqual(pivot, f)
+	}
+	if n.Left != nil {
+		if !n.Left.ascendGreaterOrEqual(pivot, f) {
+			return false
+		}
+	}
+	if !f(n.Value) {
+		return false
+	}
+	if n.Right != nil {
+		return n.Right.ascendGreaterOrEqual(pivot, f)
+	}
+	return true
+}
+
+func (n *Node[T]) descendLessOrEqual(pivot T, f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.Value.Compare(pivot) > 0 {
+		return n.Left.descendLessOrEqual(pivot, f)
+	}
+	if n.Right != nil {
+		if !n.Right.descendLessOrEqual(pivot, f) {
+			return false
+		}
+	}
+	if !f(n.Value) {
+		return false
+	}
+	if n.Left != nil {
+		return n.Left.descendLessOrEqual(pivot, f)
+	}
+	return true
+}
+
+func (n *Node[T]) ascendRange(greaterOrEqual, lessThan T, f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.Value.Compare(greaterOrEqual) < 0 {
+		return n.Right.ascendRange(greaterOrEqual, lessThan, f)
+	}
+	if n.Left != nil {
+		if !n.Left.ascendRange(greaterOrEqual, lessThan, f) {
+			return false
+		}
+	}
+	if n.Value.Compare(lessThan) >= 0 {
+		return false
+	}
+	if !f(n.Value) {
+		return false
+	}
+	if n.Right != nil {
+		return n.Right.ascendRange(greaterOrEqual, lessThan, f)
+	}
+	return true
+}
+
+func (n *Node[T]) descendRange(lessOrEqual, greaterThan T, f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.Value.Compare(lessOrEqual) > 0 {
+		return n.Left.descendRange(lessOrEqual, greaterThan, f)
+	}
+	if n.Right != nil {
+		if !n.Right.descendRange(lessOrEqual, greaterThan, f) {
+			return false
+		}
+	}
+	if n.Value.Compare(greaterThan) <= 0 {
+		return false
+	}
+	if !f(n.Value) {
+		return false
+	}
+	if n.Left != nil {
+		return n.Left.descendRange(lessOrEqual, greaterThan, f)
+	}
+	return true
+}
+
 // splay searches for a value v and
 // splays the tree as it does so.
 // If the value is found, the root
 // will be set to a node with that
 // value.
+//
+// This is Sleator & Tarjan's top-down splay: it walks down from the
+// root exactly once, assembling the nodes it passes over into a left
+// and a right subtree, and finally hangs the remainder of the search
+// path off of the node it stops at. Compared to the bottom-up,
+// recursive formulation this replaces, it performs no recursion and
+// revisits no node.
+//
+// Any node that splay mutates is path-copied first if it is shared,
+// so that a tree reachable from another Clone is left untouched.
 func splay[T Value[T], V Value[T]](root **Node[T], v V) {
 	if (*root) == nil {
 		return
 	}
-	n := (*root)
-	comparison := v.Compare(n.Value)
-	if comparison < 0 {
-		if n.Left == nil {
-			return
-		}
-		n1 := n.Left
-		comparison = v.Compare(n1.Value)
-		if comparison > 0 && n1.Right != nil {
-			splay(&n1.Right, v)
-			n2 := n1.Right
-			n.Left = n2.Right
-			n2.Right = n
-			n1.Right = n2.Left
-			n2.Left = n1
-			(*root) = n2
-		} else if comparison < 0 && n1.Left != nil {
-			splay(&n1.Left, v)
-			n2 := n1.Left
-			n.Left = n1.Right
-			n1.Right = n
-			n1.Left = n2.Right
-			n2.Right = n1
-			(*root) = n2
-		} else {
-			(*root) = n1
-			n.Left = n1.Right
-			n1.Right = n
-		}
-	} else if comparison > 0 {
-		if n.Right == nil {
-			return
-		}
-		n1 := n.Right
-		comparison = v.Compare(n1.Value)
-		if comparison < 0 && n1.Left != nil {
-			splay(&n1.Left, v)
-			n2 := n1.Left
-			n.Right = n2.Left
-			n2.Left = n
-			n1.Left = n2.Right
-			n2.Right = n1
-			(*root) = n2
-		} else if comparison > 0 && n1.Right != nil {
-			splay(&n1.Right, v)
-			n2 := n1.Right
-			n.Right = n1.Left
-			n1.Left = n
-			n1.Right = n2.Left
-			n2.Left = n1
-			(*root) = n2
+
+	comparison := v.Compare((*root).Value)
+	if comparison == 0 {
+		return
+	} else if comparison < 0 && (*root).Left == nil {
+		return
+	} else if comparison > 0 && (*root).Right == nil {
+		return
+	}
+
+	// l and r are the rightmost node of the assembled left subtree
+	// and the leftmost node of the assembled right subtree, hung off
+	// of a shared dummy header so the first attachment needs no
+	// special case.
+	var header Node[T]
+	l := &header
+	r := &header
+	t := copyIfShared(*root)
+
+	for {
+		comparison := v.Compare(t.Value)
+		if comparison < 0 {
+			if t.Left == nil {
+				break
+			}
+			if v.Compare(t.Left.Value) < 0 {
+				// zig-zig: rotate right, then keep descending left.
+				y := copyIfShared(t.Left)
+				t.Left = y.Right
+				y.Right = t
+				t = y
+				if t.Left == nil {
+					break
+				}
+			}
+			// Link t under the growing right subtree and descend.
+			r.Left = t
+			r = t
+			t = copyIfShared(t.Left)
+		} else if comparison > 0 {
+			if t.Right == nil {
+				break
+			}
+			if v.Compare(t.Right.Value) > 0 {
+				// zig-zig: rotate left, then keep descending right.
+				y := copyIfShared(t.Right)
+				t.Right = y.Left
+				y.Left = t
+				t = y
+				if t.Right == nil {
+					break
+				}
+			}
+			// Link t under the growing left subtree and descend.
+			l.Right = t
+			l = t
+			t = copyIfShared(t.Right)
 		} else {
-			(*root) = n1
-			n.Right = n1.Left
-			n1.Left = n
+			break
 		}
 	}
+
+	// Reassemble: t's remaining children become the innermost
+	// attachments of the left and right subtrees, and those subtrees
+	// become t's new children.
+	l.Right = t.Left
+	r.Left = t.Right
+	t.Left = header.Right
+	t.Right = header.Left
+	(*root) = t
+}
+
+// copyIfShared returns n, or a shallow copy of n if n.shared is set.
+// The copy's children are marked shared, since they remain reachable
+// through the original (still-shared) node as well as the copy.
+func copyIfShared[T Value[T]](n *Node[T]) *Node[T] {
+	if n == nil || !n.shared {
+		return n
+	}
+	newNode := &Node[T]{Value: n.Value, Left: n.Left, Right: n.Right}
+	if newNode.Left != nil {
+		newNode.Left.shared = true
+	}
+	if newNode.Right != nil {
+		newNode.Right.shared = true
+	}
+	return newNode
 }
 
 type greatestValue[T Value[T]] struct{}
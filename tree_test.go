@@ -2,6 +2,8 @@ package splaytree
 
 import (
 	"math/rand"
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -94,6 +96,229 @@ func TestMinMax(t *testing.T) {
 	}
 }
 
+func TestAscendDescend(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	var sorted []int
+	for i := 0; i < 200; i++ {
+		n := rand.Intn(100)
+		sorted = append(sorted, n)
+		tree.Insert(NumValue(n))
+	}
+	sort.Ints(sorted)
+
+	var ascending []int
+	tree.Ascend(func(v NumValue) bool {
+		ascending = append(ascending, int(v))
+		return true
+	})
+	if !reflect.DeepEqual(ascending, sorted) {
+		t.Fatal("Ascend did not visit values in sorted order")
+	}
+
+	var descending []int
+	tree.Descend(func(v NumValue) bool {
+		descending = append(descending, int(v))
+		return true
+	})
+	for i, j := 0, len(sorted)-1; i < len(sorted); i, j = i+1, j-1 {
+		if descending[i] != sorted[j] {
+			t.Fatal("Descend did not visit values in reverse sorted order")
+		}
+	}
+}
+
+func TestAscendRange(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	for i := 0; i < 200; i++ {
+		tree.Insert(NumValue(rand.Intn(100)))
+	}
+
+	var expected []int
+	tree.Iterate(func(v NumValue) bool {
+		if v >= 20 && v < 60 {
+			expected = append(expected, int(v))
+		}
+		return true
+	})
+
+	var actual []int
+	tree.AscendRange(NumValue(20), NumValue(60), func(v NumValue) bool {
+		actual = append(actual, int(v))
+		return true
+	})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatal("AscendRange did not visit the expected values")
+	}
+
+	var stopped []int
+	tree.AscendGreaterOrEqual(NumValue(20), func(v NumValue) bool {
+		if len(stopped) == 3 {
+			return false
+		}
+		stopped = append(stopped, int(v))
+		return true
+	})
+	if len(stopped) != 3 {
+		t.Fatal("AscendGreaterOrEqual did not stop early")
+	}
+}
+
+func TestDescendRange(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	for i := 0; i < 200; i++ {
+		tree.Insert(NumValue(rand.Intn(100)))
+	}
+
+	var expected []int
+	tree.Root.Descend(func(v NumValue) bool {
+		if v > 20 && v <= 60 {
+			expected = append(expected, int(v))
+		}
+		return true
+	})
+
+	var actual []int
+	tree.DescendRange(NumValue(60), NumValue(20), func(v NumValue) bool {
+		actual = append(actual, int(v))
+		return true
+	})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatal("DescendRange did not visit the expected values")
+	}
+}
+
+func TestCloneInsert(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	for i := 0; i < 500; i++ {
+		tree.Insert(NumValue(rand.Intn(1000)))
+	}
+
+	clone := tree.Clone()
+	before := treeValues(clone)
+
+	tree.Insert(NumValue(rand.Intn(1000)))
+	tree.Delete(NumValue(rand.Intn(1000)))
+
+	after := treeValues(clone)
+	if !reflect.DeepEqual(before, after) {
+		t.Fatal("mutating the original tree changed the clone")
+	}
+	if !properlySorted(clone.Root) {
+		t.Fatal("clone is not properly sorted")
+	}
+}
+
+func TestCloneDelete(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	for i := 0; i < 500; i++ {
+		tree.Insert(NumValue(rand.Intn(1000)))
+	}
+
+	clone := tree.Clone()
+	before := treeValues(tree)
+
+	clone.Insert(NumValue(rand.Intn(1000)))
+	clone.Delete(NumValue(rand.Intn(1000)))
+
+	after := treeValues(tree)
+	if !reflect.DeepEqual(before, after) {
+		t.Fatal("mutating the clone changed the original tree")
+	}
+	if !properlySorted(tree.Root) {
+		t.Fatal("original tree is not properly sorted")
+	}
+}
+
+func treeValues(t *Tree[NumValue]) []int {
+	var values []int
+	t.Iterate(func(v NumValue) bool {
+		values = append(values, int(v))
+		return true
+	})
+	return values
+}
+
+func TestIteratorForward(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	var sorted []int
+	for i := 0; i < 200; i++ {
+		n := rand.Intn(100)
+		sorted = append(sorted, n)
+		tree.Insert(NumValue(n))
+	}
+	sort.Ints(sorted)
+
+	it := tree.Iterator()
+	var values []int
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		values = append(values, int(v))
+	}
+	if !reflect.DeepEqual(values, sorted) {
+		t.Fatal("Next() did not visit values in sorted order")
+	}
+	if _, ok := it.Next(); ok {
+		t.Fatal("Next() should return false once exhausted")
+	}
+}
+
+func TestIteratorBackward(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	var sorted []int
+	for i := 0; i < 200; i++ {
+		n := rand.Intn(100)
+		sorted = append(sorted, n)
+		tree.Insert(NumValue(n))
+	}
+	sort.Ints(sorted)
+
+	it := tree.Iterator()
+	it.SeekLast()
+	var values []int
+	values = append(values, int(it.Value()))
+	for v, ok := it.Prev(); ok; v, ok = it.Prev() {
+		values = append(values, int(v))
+	}
+	for i, j := 0, len(sorted)-1; i < len(sorted); i, j = i+1, j-1 {
+		if values[i] != sorted[j] {
+			t.Fatalf("Prev() did not visit values in reverse sorted order: %v vs %v", values, sorted)
+		}
+	}
+	if _, ok := it.Prev(); ok {
+		t.Fatal("Prev() should return false once exhausted")
+	}
+}
+
+func TestIteratorSeek(t *testing.T) {
+	tree := &Tree[NumValue]{}
+	for _, n := range []int{10, 20, 30, 40, 50} {
+		tree.Insert(NumValue(n))
+	}
+
+	it := tree.Iterator()
+	it.Seek(NumValue(25))
+	if it.Value() != NumValue(20) {
+		t.Fatalf("expected floor(25) = 20 but got %v", it.Value())
+	}
+	if v, ok := it.Next(); !ok || v != NumValue(30) {
+		t.Fatalf("expected next value 30 but got %v, %v", v, ok)
+	}
+
+	it.Seek(NumValue(5))
+	if _, ok := it.Prev(); ok {
+		t.Fatal("expected no value before the floor of 5")
+	}
+	if v, ok := it.Next(); !ok || v != NumValue(10) {
+		t.Fatalf("expected first value 10 but got %v, %v", v, ok)
+	}
+
+	it.Seek(NumValue(30))
+	if it.Value() != NumValue(30) {
+		t.Fatalf("expected exact match 30 but got %v", it.Value())
+	}
+}
+
 func BenchmarkInsertions(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		var tree Tree[NumValue]
@@ -103,6 +328,50 @@ func BenchmarkInsertions(b *testing.B) {
 	}
 }
 
+// BenchmarkSplayRandom exercises splay on a tree built from randomly
+// ordered inserts, so accesses hit all parts of the tree roughly
+// uniformly.
+func BenchmarkSplayRandom(b *testing.B) {
+	keys := make([]NumValue, 4096)
+	for i := range keys {
+		keys[i] = NumValue(i)
+	}
+	rand.Shuffle(len(keys), func(i, j int) {
+		keys[i], keys[j] = keys[j], keys[i]
+	})
+
+	var tree Tree[NumValue]
+	for _, k := range keys {
+		tree.Insert(k)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Delete(keys[i%len(keys)])
+		tree.Insert(keys[i%len(keys)])
+	}
+}
+
+// BenchmarkSplaySkewed exercises splay on a tree built from
+// ascending, already-sorted inserts (the classic degenerate case for
+// an unbalanced BST), then repeatedly re-accesses a small hot set of
+// keys near the end of the tree.
+func BenchmarkSplaySkewed(b *testing.B) {
+	const n = 4096
+	var tree Tree[NumValue]
+	for i := 0; i < n; i++ {
+		tree.Insert(NumValue(i))
+	}
+	hotKeys := []NumValue{NumValue(n - 1), NumValue(n - 2), NumValue(n - 3)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := hotKeys[i%len(hotKeys)]
+		tree.Delete(k)
+		tree.Insert(k)
+	}
+}
+
 func properlySorted(t *Node[NumValue]) bool {
 	if t == nil {
 		return true